@@ -0,0 +1,86 @@
+// Declarative model setup for LiteLLM through a config file.
+//
+// NewLiteLLMFromConfig reads a YAML file describing one or more model
+// aliases — each with its own system prompt, sampling defaults, tool
+// allowlist, and optional per-model proxy URL override — and returns
+// ready-to-use core.Model instances keyed by alias. This removes the
+// repetitive openai.NewLiteLLM(...).WithModel(...) boilerplate seen in
+// the other examples in this directory.
+//
+// Usage:
+//
+//	litellm --model gpt-4o
+//	litellm --model gpt-4o-mini --port 4001   # the "summarizer" alias's proxy_url
+//	go run ./config
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"runtime"
+
+	"github.com/fugue-labs/gollem/core"
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+// WeatherParams mirrors the tool used in ../tools, kept here so this
+// example can show a tool allowlist: models.yaml lists "get_weather" in
+// the "coder" alias's tools. An alias whose tools list leaves a tool out
+// has it filtered out; an alias with no tools list is not restricted.
+type WeatherParams struct {
+	City string `json:"city" description:"City name to get weather for"`
+}
+
+func main() {
+	// Resolve models.yaml relative to this source file rather than the
+	// process's working directory, so the example runs the same whether
+	// invoked as `go run ./config` or `cd config && go run .`.
+	_, thisFile, _, _ := runtime.Caller(0)
+	configPath := filepath.Join(filepath.Dir(thisFile), "models.yaml")
+
+	models, err := openai.NewLiteLLMFromConfig(configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	weatherTool := core.FuncTool[WeatherParams](
+		"get_weather",
+		"Get current weather for a city",
+		func(ctx context.Context, p WeatherParams) (string, error) {
+			return fmt.Sprintf("Weather in %s: 72°F, sunny", p.City), nil
+		},
+	)
+
+	// "coder" lists get_weather in its tool allowlist, so the tool we
+	// register here is actually offered to the model; an alias whose
+	// tools list left it out would have it filtered out instead.
+	coder, ok := models["coder"]
+	if !ok {
+		log.Fatal("models.yaml: no \"coder\" model configured")
+	}
+	coderAgent := core.NewAgent[string](coder, core.WithTools[string](weatherTool))
+
+	result, err := coderAgent.Run(context.Background(), "Write a Go function that reverses a slice of ints, then check the weather in Tokyo.")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("coder:", result.Output)
+
+	// "summarizer" runs against a different proxy_url (a separate,
+	// cheaper LiteLLM deployment) and its own temperature/system prompt,
+	// entirely driven by models.yaml — no WithModel/WithSystemPrompt
+	// boilerplate needed here.
+	summarizer, ok := models["summarizer"]
+	if !ok {
+		log.Fatal("models.yaml: no \"summarizer\" model configured")
+	}
+	summarizerAgent := core.NewAgent[string](summarizer)
+
+	result, err = summarizerAgent.Run(context.Background(), "LiteLLM is a proxy that exposes a single OpenAI-compatible API in front of 100+ LLM providers, with routing, fallbacks, caching, and budgets.")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("summarizer:", result.Output)
+}
@@ -0,0 +1,77 @@
+// Embeddings and cosine similarity through a LiteLLM proxy.
+//
+// LiteLLM proxies OpenAI's /v1/embeddings endpoint, so any embedding
+// model (or alias) configured in LiteLLM can be used from gollem agents
+// for retrieval-augmented generation.
+//
+// Usage:
+//
+//	litellm --model text-embedding-3-small
+//	go run ./embeddings
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+var corpus = []string{
+	"The cat sat on the mat.",
+	"Dogs are loyal companions.",
+	"Quantum computers use qubits instead of bits.",
+	"Distributed systems must tolerate partial failures.",
+}
+
+func main() {
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	model := openai.NewLiteLLM(proxyURL,
+		openai.WithModel("text-embedding-3-small"), // any embedding alias configured in LiteLLM
+	)
+
+	ctx := context.Background()
+
+	query := "How do distributed systems handle failure?"
+	queryEmb, usage, err := model.Embed(ctx, []string{query})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("query embedding tokens used: %d\n", usage.InputTokens)
+
+	corpusEmb, usage, err := model.Embed(ctx, corpus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("corpus embedding tokens used: %d\n", usage.InputTokens)
+
+	bestIdx, bestScore := -1, -1.0
+	for i, emb := range corpusEmb {
+		score := cosineSimilarity(queryEmb[0], emb)
+		fmt.Printf("%.4f  %s\n", score, corpus[i])
+		if score > bestScore {
+			bestIdx, bestScore = i, score
+		}
+	}
+	fmt.Printf("\nbest match: %q (%.4f)\n", corpus[bestIdx], bestScore)
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
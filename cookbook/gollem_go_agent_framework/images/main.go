@@ -0,0 +1,61 @@
+// Image generation through a LiteLLM proxy.
+//
+// LiteLLM proxies OpenAI's /v1/images/generations endpoint (and Stable
+// Diffusion backends that speak the same API), so the same provider used
+// for chat can also generate images.
+//
+// Usage:
+//
+//	litellm --model dall-e-3   # start proxy in another terminal
+//	go run ./images
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+func main() {
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	model := openai.NewLiteLLM(proxyURL,
+		openai.WithModel("dall-e-3"), // any image model configured in LiteLLM
+	)
+
+	ctx := context.Background()
+
+	resp, err := model.GenerateImage(ctx, openai.ImageRequest{
+		Prompt:         "a watercolor painting of a lighthouse at sunset",
+		Size:           "1024x1024",
+		N:              1,
+		ResponseFormat: "b64_json",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for i, data := range resp.Data {
+		switch {
+		case data.URL != "":
+			fmt.Printf("image %d: %s\n", i, data.URL)
+		case data.B64JSON != "":
+			raw, err := base64.StdEncoding.DecodeString(data.B64JSON)
+			if err != nil {
+				log.Fatal(err)
+			}
+			name := fmt.Sprintf("image-%d.png", i)
+			if err := os.WriteFile(name, raw, 0o644); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("image %d: wrote %s (%d bytes)\n", i, name, len(raw))
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// maxToolTurns bounds the tool-call loop in Run/RunStream so a model that
+// never stops calling tools can't hang an agent forever.
+const maxToolTurns = 8
+
+// Agent drives a Model through a system prompt, a tool loop, and
+// (optionally) a fallback model chain. T is the parsed output type;
+// plain text agents use Agent[string].
+type Agent[T any] struct {
+	model        Model
+	systemPrompt string
+	tools        []Tool
+	modelChain   []string
+}
+
+// Option configures an Agent[T] constructed by NewAgent.
+type Option[T any] func(*Agent[T])
+
+// NewAgent creates an Agent backed by model, applying the given options.
+func NewAgent[T any](model Model, opts ...Option[T]) *Agent[T] {
+	a := &Agent[T]{model: model}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// WithSystemPrompt sets the system prompt sent on every turn.
+func WithSystemPrompt[T any](prompt string) Option[T] {
+	return func(a *Agent[T]) { a.systemPrompt = prompt }
+}
+
+// WithTools registers tools the model may call during Run/RunStream.
+func WithTools[T any](tools ...Tool) Option[T] {
+	return func(a *Agent[T]) { a.tools = append(a.tools, tools...) }
+}
+
+// WithModelFallback sets the model to try first (primary) and the models
+// to fall back to, in order, when a provider reports the primary is
+// unavailable (e.g. LiteLLM returning 429/5xx). The primary here is the
+// one that's actually used — it takes precedence over any model name
+// configured on the provider itself.
+func WithModelFallback[T any](primary string, fallbacks ...string) Option[T] {
+	return func(a *Agent[T]) { a.modelChain = append([]string{primary}, fallbacks...) }
+}
+
+// Result is what Run returns: the parsed output plus usage and routing
+// metadata from the underlying model call.
+type Result[T any] struct {
+	Output  T
+	Usage   Usage
+	Routing Routing
+	Budget  Budget
+}
+
+// Run sends input to the model, resolving any tool calls the model makes
+// along the way, and returns the final parsed output.
+func (a *Agent[T]) Run(ctx context.Context, input string) (Result[T], error) {
+	resp, err := a.converse(ctx, input, func(req ChatRequest) (ChatResponse, error) {
+		return a.model.Chat(ctx, req)
+	})
+	if err != nil {
+		var zero Result[T]
+		return zero, err
+	}
+	return a.toResult(resp)
+}
+
+// converse runs the system-prompt + tool-call loop shared by Run and
+// RunStream; call drives a single model turn.
+func (a *Agent[T]) converse(ctx context.Context, input string, call func(ChatRequest) (ChatResponse, error)) (ChatResponse, error) {
+	messages := []Message{{Role: RoleUser, Content: input}}
+
+	var resp ChatResponse
+	for turn := 0; turn < maxToolTurns; turn++ {
+		req := ChatRequest{
+			SystemPrompt: a.systemPrompt,
+			Messages:     messages,
+			Tools:        a.tools,
+			ModelChain:   a.modelChain,
+		}
+
+		var err error
+		resp, err = call(req)
+		if err != nil {
+			return ChatResponse{}, err
+		}
+		if len(resp.ToolCalls) == 0 {
+			return resp, nil
+		}
+
+		messages = append(messages, Message{Role: RoleAssistant, Content: resp.Content, ToolCalls: resp.ToolCalls})
+		for _, tc := range resp.ToolCalls {
+			result, err := a.callTool(ctx, tc)
+			if err != nil {
+				result = fmt.Sprintf("error: %v", err)
+			}
+			messages = append(messages, Message{Role: RoleTool, ToolCallID: tc.ID, Content: result})
+		}
+	}
+	return resp, nil
+}
+
+func (a *Agent[T]) callTool(ctx context.Context, tc ToolCall) (string, error) {
+	for _, t := range a.tools {
+		if t.Name == tc.Name {
+			return t.Handler(ctx, tc.Arguments)
+		}
+	}
+	return "", fmt.Errorf("core: model called unknown tool %q", tc.Name)
+}
+
+func (a *Agent[T]) toResult(resp ChatResponse) (Result[T], error) {
+	output, err := convertOutput[T](resp.Content)
+	if err != nil {
+		var zero Result[T]
+		return zero, err
+	}
+	return Result[T]{
+		Output:  output,
+		Usage:   resp.Usage,
+		Routing: resp.Routing,
+		Budget:  resp.Budget,
+	}, nil
+}
+
+// convertOutput converts a model's text content into T. Agent[string] is
+// the common case and returns the text as-is; any other T is parsed as
+// JSON.
+func convertOutput[T any](content string) (T, error) {
+	var out T
+	if s, ok := any(&out).(*string); ok {
+		*s = content
+		return out, nil
+	}
+	if err := json.Unmarshal([]byte(content), &out); err != nil {
+		var zero T
+		return zero, fmt.Errorf("core: could not parse model output as %T: %w", zero, err)
+	}
+	return out, nil
+}
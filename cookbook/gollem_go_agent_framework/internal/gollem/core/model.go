@@ -0,0 +1,79 @@
+// Package core provides the provider-agnostic agent runtime: messages,
+// tools, and the Model interface that providers (e.g. provider/openai)
+// implement.
+package core
+
+import "context"
+
+// Role identifies the speaker of a Message.
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+	RoleTool      Role = "tool"
+)
+
+// Message is a single turn in a conversation.
+type Message struct {
+	Role       Role
+	Content    string
+	ToolCallID string // set on RoleTool messages: which ToolCall this answers
+	ToolCalls  []ToolCall
+}
+
+// Usage reports token consumption for a single model call.
+type Usage struct {
+	InputTokens  int
+	OutputTokens int
+}
+
+// Routing reports how a LiteLLM-style proxy routed a request across a
+// model group (see provider/openai.WithModel and core.WithModelFallback).
+type Routing struct {
+	ModelUsed string
+	Attempts  []string
+	Cost      float64
+	CacheHit  bool
+}
+
+// Budget reports a virtual key's remaining spend, as surfaced by a
+// LiteLLM-style proxy's response headers.
+type Budget struct {
+	SpentUSD          float64
+	MaxBudgetUSD      float64
+	RemainingRequests int
+	RemainingTokens   int
+}
+
+// ChatRequest is what an Agent sends to a Model on each turn.
+type ChatRequest struct {
+	SystemPrompt string
+	Messages     []Message
+	Tools        []Tool
+
+	// ModelChain is the primary model followed by its fallbacks, as set
+	// by core.WithModelFallback. A Model implementation that supports
+	// routing (e.g. provider/openai.LiteLLM) tries them in order.
+	ModelChain []string
+}
+
+// ChatResponse is a Model's answer to a ChatRequest.
+type ChatResponse struct {
+	Content   string
+	ToolCalls []ToolCall
+	Usage     Usage
+	Routing   Routing
+	Budget    Budget
+}
+
+// Model is implemented by providers (provider/openai.LiteLLM) and driven
+// by Agent.
+type Model interface {
+	Chat(ctx context.Context, req ChatRequest) (ChatResponse, error)
+
+	// ChatStream behaves like Chat but invokes onDelta with each text
+	// chunk as it arrives, in addition to returning the final response.
+	ChatStream(ctx context.Context, req ChatRequest, onDelta func(string)) (ChatResponse, error)
+}
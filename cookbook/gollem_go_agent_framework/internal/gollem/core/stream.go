@@ -0,0 +1,54 @@
+package core
+
+import "context"
+
+// StreamResult is returned by RunStream. It holds every text delta the
+// model produced during the run; range over StreamText to read them in
+// order. Response is populated by the time RunStream returns.
+type StreamResult struct {
+	deltas []string
+	final  ChatResponse
+}
+
+// RunStream behaves like Run, resolving any tool calls along the way
+// exactly as Run does, but calls the model's streaming API and records
+// each text delta it delivers. It returns only after the whole run has
+// finished; no delta reaches the caller while generation is in progress.
+func (a *Agent[T]) RunStream(ctx context.Context, input string) (*StreamResult, error) {
+	sr := &StreamResult{}
+	resp, err := a.converse(ctx, input, func(req ChatRequest) (ChatResponse, error) {
+		return a.model.ChatStream(ctx, req, func(delta string) {
+			sr.deltas = append(sr.deltas, delta)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sr.final = resp
+	return sr, nil
+}
+
+// StreamText returns a range-over-func iterator (Go 1.23+) yielding the
+// text chunks recorded by RunStream, in the order the model produced
+// them. When deltasOnly is true each yielded string is
+// just the new chunk; when false it's the text accumulated so far.
+func (sr *StreamResult) StreamText(deltasOnly bool) func(func(string, error) bool) {
+	return func(yield func(string, error) bool) {
+		accum := ""
+		for _, d := range sr.deltas {
+			accum += d
+			text := d
+			if !deltasOnly {
+				text = accum
+			}
+			if !yield(text, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Response returns the final, fully-assembled response of the run.
+func (sr *StreamResult) Response() ChatResponse {
+	return sr.final
+}
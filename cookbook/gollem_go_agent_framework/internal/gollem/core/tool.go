@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// ToolCall is a request from the model to invoke a Tool by name.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments json.RawMessage
+}
+
+// Tool is a named, schema-described function an Agent can offer to the
+// model. Build one with FuncTool rather than constructing it directly.
+type Tool struct {
+	Name        string
+	Description string
+	Schema      map[string]any
+	Handler     func(ctx context.Context, args json.RawMessage) (string, error)
+}
+
+// FuncTool builds a type-safe Tool from a Go function. The JSON schema
+// for P is generated once, at registration time, from its struct tags:
+//
+//	type Params struct {
+//	    City string `json:"city" description:"City name"`
+//	}
+func FuncTool[P any](name, description string, fn func(ctx context.Context, p P) (string, error)) Tool {
+	var zero P
+	return Tool{
+		Name:        name,
+		Description: description,
+		Schema:      schemaFor(reflect.TypeOf(zero)),
+		Handler: func(ctx context.Context, raw json.RawMessage) (string, error) {
+			var p P
+			if len(raw) > 0 {
+				if err := json.Unmarshal(raw, &p); err != nil {
+					return "", err
+				}
+			}
+			return fn(ctx, p)
+		},
+	}
+}
+
+// schemaFor builds a minimal JSON Schema object for a struct type from
+// its `json` and `description` tags.
+func schemaFor(t reflect.Type) map[string]any {
+	props := map[string]any{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := f.Name, ""
+		if tag != "" {
+			name = tag
+			if idx := indexComma(tag); idx >= 0 {
+				name, opts = tag[:idx], tag[idx+1:]
+			}
+		}
+
+		prop := map[string]any{"type": jsonType(f.Type)}
+		if desc := f.Tag.Get("description"); desc != "" {
+			prop["description"] = desc
+		}
+		props[name] = prop
+
+		if opts != "omitempty" {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": props,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func indexComma(tag string) int {
+	for i, r := range tag {
+		if r == ',' {
+			return i
+		}
+	}
+	return -1
+}
+
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
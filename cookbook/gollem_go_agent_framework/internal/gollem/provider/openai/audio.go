@@ -0,0 +1,125 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// TranscribeOpts configures a call to Transcribe.
+type TranscribeOpts struct {
+	Model          string // defaults to the provider's configured model if empty
+	Language       string // ISO-639-1 code, e.g. "en"; optional
+	ResponseFormat string // "json", "text", "srt", "verbose_json", or "vtt"
+}
+
+// Transcribe uploads audio to the proxy's /v1/audio/transcriptions
+// endpoint (Whisper) as multipart form data and returns the response body
+// as-is: the plain transcript for ResponseFormat "text", otherwise the
+// document in the requested format (a JSON object for "json" or when
+// ResponseFormat is empty).
+func (m *LiteLLM) Transcribe(ctx context.Context, audio io.Reader, opts TranscribeOpts) (string, error) {
+	model := opts.Model
+	if model == "" {
+		model = m.model
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile("file", "audio")
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(part, audio); err != nil {
+		return "", err
+	}
+	if err := w.WriteField("model", model); err != nil {
+		return "", err
+	}
+	if opts.Language != "" {
+		if err := w.WriteField("language", opts.Language); err != nil {
+			return "", err
+		}
+	}
+	if opts.ResponseFormat != "" {
+		if err := w.WriteField("response_format", opts.ResponseFormat); err != nil {
+			return "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/v1/audio/transcriptions", &body)
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", w.FormDataContentType())
+	m.setCommonHeaders(httpReq.Header)
+
+	resp, err := m.client.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := readAndClose(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("openai: litellm returned %d: %s", resp.StatusCode, respBody)
+	}
+	return string(respBody), nil
+}
+
+// SpeechRequest describes a request to the proxy's /v1/audio/speech
+// (TTS) endpoint.
+type SpeechRequest struct {
+	Input          string
+	Voice          string // e.g. "alloy"
+	ResponseFormat string // "mp3", "opus", "aac", "flac", "wav", or "pcm"
+}
+
+type speechRequestBody struct {
+	Model          string `json:"model,omitempty"`
+	Input          string `json:"input"`
+	Voice          string `json:"voice,omitempty"`
+	ResponseFormat string `json:"response_format,omitempty"`
+}
+
+// Speech calls the proxy's /v1/audio/speech endpoint and returns the
+// audio as a stream. When the underlying model supports it, LiteLLM
+// streams bytes as they're generated, so callers can start playing audio
+// before generation finishes. Callers must Close the returned reader.
+func (m *LiteLLM) Speech(ctx context.Context, req SpeechRequest) (io.ReadCloser, error) {
+	payload, err := json.Marshal(speechRequestBody{
+		Model:          m.model,
+		Input:          req.Input,
+		Voice:          req.Voice,
+		ResponseFormat: req.ResponseFormat,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/v1/audio/speech", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	m.setCommonHeaders(httpReq.Header)
+
+	resp, err := m.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		body, _ := readAndClose(resp.Body)
+		return nil, fmt.Errorf("openai: litellm returned %d: %s", resp.StatusCode, body)
+	}
+	return resp.Body, nil
+}
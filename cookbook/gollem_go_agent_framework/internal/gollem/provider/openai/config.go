@@ -0,0 +1,171 @@
+package openai
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fugue-labs/gollem/core"
+)
+
+// modelConfig is one entry under the top-level `models:` key of a
+// NewLiteLLMFromConfig file.
+type modelConfig struct {
+	model        string
+	proxyURL     string
+	systemPrompt string
+	temperature  *float64
+	topP         *float64
+	tools        []string
+}
+
+// NewLiteLLMFromConfig reads a YAML file declaring one or more model
+// aliases and returns a ready-to-use core.Model per alias, keyed by
+// alias name. Each alias may set its own system prompt, sampling
+// defaults, tool allowlist, and proxy URL override; aliases that don't
+// set proxy_url fall back to the file's top-level proxy_url.
+//
+// This parses only the small, flat subset of YAML the checked-in
+// models.yaml files in this directory use (scalars, `#` comments, and
+// one level of `- item` lists) — it is not a general-purpose YAML
+// parser.
+func NewLiteLLMFromConfig(path string) (map[string]core.Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("openai: reading config: %w", err)
+	}
+	defer f.Close()
+
+	defaultProxyURL, configs, err := parseModelsYAML(f)
+	if err != nil {
+		return nil, fmt.Errorf("openai: parsing %s: %w", path, err)
+	}
+
+	models := make(map[string]core.Model, len(configs))
+	for alias, c := range configs {
+		proxyURL := c.proxyURL
+		if proxyURL == "" {
+			proxyURL = defaultProxyURL
+		}
+		if proxyURL == "" {
+			return nil, fmt.Errorf("openai: model %q has no proxy_url and no top-level default is set", alias)
+		}
+
+		opts := []Option{WithModel(c.model), WithSystemPrompt(c.systemPrompt)}
+		if c.temperature != nil {
+			opts = append(opts, WithTemperature(*c.temperature))
+		}
+		if c.topP != nil {
+			opts = append(opts, WithTopP(*c.topP))
+		}
+		if len(c.tools) > 0 {
+			opts = append(opts, WithAllowedTools(c.tools...))
+		}
+		models[alias] = NewLiteLLM(proxyURL, opts...)
+	}
+	return models, nil
+}
+
+// parseModelsYAML walks the file line by line tracking indentation:
+// depth 0 is top-level keys, depth 1 is under `models:` (alias names),
+// depth 2 is an alias's own fields (plus depth 3 for `tools:` list items).
+func parseModelsYAML(f *os.File) (defaultProxyURL string, configs map[string]*modelConfig, err error) {
+	configs = map[string]*modelConfig{}
+
+	var inModels bool
+	var current *modelConfig
+	var inTools bool
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		raw := scanner.Text()
+		line := stripComment(raw)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case indent == 0 && trimmed == "models:":
+			inModels = true
+			current = nil
+			inTools = false
+
+		case indent == 0:
+			inModels = false
+			key, value := splitKeyValue(trimmed)
+			if key == "proxy_url" {
+				defaultProxyURL = value
+			}
+
+		case indent == 2 && inModels && strings.HasSuffix(trimmed, ":") && !strings.Contains(trimmed, ": "):
+			alias := strings.TrimSuffix(trimmed, ":")
+			current = &modelConfig{}
+			configs[alias] = current
+			inTools = false
+
+		case indent == 4 && current != nil && trimmed == "tools:":
+			inTools = true
+
+		case indent == 6 && inTools && strings.HasPrefix(trimmed, "- "):
+			current.tools = append(current.tools, strings.TrimSpace(strings.TrimPrefix(trimmed, "- ")))
+
+		case indent == 4 && current != nil:
+			inTools = false
+			key, value := splitKeyValue(trimmed)
+			switch key {
+			case "model":
+				current.model = value
+			case "proxy_url":
+				current.proxyURL = value
+			case "system_prompt":
+				current.systemPrompt = value
+			case "temperature":
+				t, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return "", nil, fmt.Errorf("temperature: %w", err)
+				}
+				current.temperature = &t
+			case "top_p":
+				p, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return "", nil, fmt.Errorf("top_p: %w", err)
+				}
+				current.topP = &p
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, err
+	}
+	return defaultProxyURL, configs, nil
+}
+
+func stripComment(line string) string {
+	inQuote := false
+	for i, r := range line {
+		switch r {
+		case '"':
+			inQuote = !inQuote
+		case '#':
+			if !inQuote {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+func splitKeyValue(s string) (key, value string) {
+	idx := strings.Index(s, ":")
+	if idx < 0 {
+		return s, ""
+	}
+	key = strings.TrimSpace(s[:idx])
+	value = strings.TrimSpace(s[idx+1:])
+	value = strings.Trim(value, `"`)
+	return key, value
+}
@@ -0,0 +1,103 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fugue-labs/gollem/core"
+)
+
+// defaultEmbedBatchSize is how many inputs Embed sends per request when
+// the caller hasn't overridden it with WithEmbedBatchSize. LiteLLM and
+// most upstream embedding APIs cap batch size well above this, so 96
+// leaves headroom while keeping individual requests small.
+const defaultEmbedBatchSize = 96
+
+// WithEmbedBatchSize overrides how many inputs Embed sends per request
+// before chunking into another call.
+func WithEmbedBatchSize(n int) Option {
+	return func(m *LiteLLM) { m.embedBatchSize = n }
+}
+
+type embeddingsRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Usage struct {
+		PromptTokens int `json:"prompt_tokens"`
+	} `json:"usage"`
+}
+
+// Embed returns one embedding vector per input string, in the same
+// order, by POSTing to the proxy's /v1/embeddings endpoint. Inputs are
+// chunked into batches of m.embedBatchSize (96 by default, see
+// WithEmbedBatchSize) to stay under backend request-size limits.
+func (m *LiteLLM) Embed(ctx context.Context, inputs []string) ([][]float32, core.Usage, error) {
+	batchSize := m.embedBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultEmbedBatchSize
+	}
+
+	out := make([][]float32, 0, len(inputs))
+	var usage core.Usage
+	for start := 0; start < len(inputs); start += batchSize {
+		end := start + batchSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		embeddings, batchUsage, err := m.embedBatch(ctx, inputs[start:end])
+		if err != nil {
+			return nil, core.Usage{}, fmt.Errorf("openai: embedding batch [%d:%d]: %w", start, end, err)
+		}
+		out = append(out, embeddings...)
+		usage.InputTokens += batchUsage.InputTokens
+	}
+	return out, usage, nil
+}
+
+func (m *LiteLLM) embedBatch(ctx context.Context, inputs []string) ([][]float32, core.Usage, error) {
+	payload, err := json.Marshal(embeddingsRequest{Model: m.model, Input: inputs})
+	if err != nil {
+		return nil, core.Usage{}, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/v1/embeddings", bytes.NewReader(payload))
+	if err != nil {
+		return nil, core.Usage{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	m.setCommonHeaders(httpReq.Header)
+
+	resp, err := m.client.Do(httpReq)
+	if err != nil {
+		return nil, core.Usage{}, err
+	}
+	body, err := readAndClose(resp.Body)
+	if err != nil {
+		return nil, core.Usage{}, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, core.Usage{}, fmt.Errorf("litellm returned %d: %s", resp.StatusCode, body)
+	}
+
+	var parsed embeddingsResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, core.Usage{}, fmt.Errorf("decoding response: %w", err)
+	}
+
+	out := make([][]float32, len(parsed.Data))
+	for _, d := range parsed.Data {
+		out[d.Index] = d.Embedding
+	}
+	return out, core.Usage{InputTokens: parsed.Usage.PromptTokens}, nil
+}
@@ -0,0 +1,91 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ImageRequest describes a request to an OpenAI-compatible
+// /v1/images/generations endpoint, which LiteLLM proxies for both
+// DALL·E and Stable Diffusion backends.
+type ImageRequest struct {
+	Prompt         string
+	Size           string // e.g. "1024x1024"; empty uses the backend's default
+	N              int    // number of images to generate; 0 defaults to 1
+	ResponseFormat string // "url" or "b64_json"
+}
+
+// ImageData is one generated image, populated according to the
+// requested ResponseFormat.
+type ImageData struct {
+	URL     string
+	B64JSON string
+}
+
+// ImageResponse is the result of GenerateImage.
+type ImageResponse struct {
+	Data []ImageData
+}
+
+type imageGenerationRequest struct {
+	Model          string `json:"model,omitempty"`
+	Prompt         string `json:"prompt"`
+	Size           string `json:"size,omitempty"`
+	N              int    `json:"n,omitempty"`
+	ResponseFormat string `json:"response_format,omitempty"`
+}
+
+type imageGenerationResponse struct {
+	Data []struct {
+		URL     string `json:"url"`
+		B64JSON string `json:"b64_json"`
+	} `json:"data"`
+}
+
+// GenerateImage calls the proxy's /v1/images/generations endpoint. The
+// context can be used to cancel a slow generation mid-flight.
+func (m *LiteLLM) GenerateImage(ctx context.Context, req ImageRequest) (ImageResponse, error) {
+	payload, err := json.Marshal(imageGenerationRequest{
+		Model:          m.model,
+		Prompt:         req.Prompt,
+		Size:           req.Size,
+		N:              req.N,
+		ResponseFormat: req.ResponseFormat,
+	})
+	if err != nil {
+		return ImageResponse{}, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/v1/images/generations", bytes.NewReader(payload))
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	m.setCommonHeaders(httpReq.Header)
+
+	resp, err := m.client.Do(httpReq)
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	body, err := readAndClose(resp.Body)
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	if resp.StatusCode >= 400 {
+		return ImageResponse{}, fmt.Errorf("openai: litellm returned %d: %s", resp.StatusCode, body)
+	}
+
+	var parsed imageGenerationResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return ImageResponse{}, fmt.Errorf("openai: decoding response: %w", err)
+	}
+
+	out := ImageResponse{Data: make([]ImageData, len(parsed.Data))}
+	for i, d := range parsed.Data {
+		out.Data[i] = ImageData{URL: d.URL, B64JSON: d.B64JSON}
+	}
+	return out, nil
+}
@@ -0,0 +1,449 @@
+// Package openai implements gollem's core.Model against OpenAI-compatible
+// HTTP APIs, primarily a LiteLLM proxy (https://docs.litellm.ai/).
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fugue-labs/gollem/core"
+)
+
+// Headers a LiteLLM proxy sends back describing how it routed a request.
+// Budget-related headers are declared in virtualkey.go, alongside the
+// options that cause LiteLLM to populate them.
+const (
+	headerResponseCost = "x-litellm-response-cost"
+	headerCacheStatus  = "x-litellm-cache-status" // "hit" | "miss"
+)
+
+// retryableStatus reports whether a LiteLLM response status means "try
+// the next model in the fallback chain" rather than "give up".
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// LiteLLM is a core.Model backed by a LiteLLM proxy (or any other
+// OpenAI-compatible server).
+type LiteLLM struct {
+	baseURL string
+	client  *http.Client
+
+	model          string
+	systemPrompt   string
+	temperature    *float64
+	topP           *float64
+	allowedTools   map[string]bool
+	embedBatchSize int
+
+	virtualKey string
+	tags       []string
+	user       string
+	team       string
+}
+
+// Option configures a LiteLLM provider constructed by NewLiteLLM.
+type Option func(*LiteLLM)
+
+// NewLiteLLM creates a provider pointed at a LiteLLM proxy (or any other
+// OpenAI-compatible server) running at baseURL, e.g. http://localhost:4000.
+func NewLiteLLM(baseURL string, opts ...Option) *LiteLLM {
+	m := &LiteLLM{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithModel sets the model name (or LiteLLM-configured alias) to use when
+// the caller hasn't set a fallback chain via core.WithModelFallback.
+func WithModel(name string) Option {
+	return func(m *LiteLLM) { m.model = name }
+}
+
+// WithTemperature sets the sampling temperature sent with every request.
+func WithTemperature(t float64) Option {
+	return func(m *LiteLLM) { m.temperature = &t }
+}
+
+// WithTopP sets nucleus sampling probability sent with every request.
+func WithTopP(p float64) Option {
+	return func(m *LiteLLM) { m.topP = &p }
+}
+
+// WithSystemPrompt sets a default system prompt used when the agent
+// driving this model doesn't set one itself via core.WithSystemPrompt.
+func WithSystemPrompt(prompt string) Option {
+	return func(m *LiteLLM) { m.systemPrompt = prompt }
+}
+
+// WithAllowedTools restricts which of the tools an agent registers via
+// core.WithTools are actually offered to the model for this provider
+// instance. An empty allowlist (the default) places no restriction.
+func WithAllowedTools(names ...string) Option {
+	return func(m *LiteLLM) {
+		m.allowedTools = make(map[string]bool, len(names))
+		for _, n := range names {
+			m.allowedTools[n] = true
+		}
+	}
+}
+
+// modelChain returns the models to try, in order: the agent's fallback
+// chain (core.WithModelFallback) if set, otherwise just this provider's
+// configured model.
+func (m *LiteLLM) modelChain(req core.ChatRequest) []string {
+	if len(req.ModelChain) > 0 {
+		return req.ModelChain
+	}
+	return []string{m.model}
+}
+
+func (m *LiteLLM) filterTools(tools []core.Tool) []core.Tool {
+	if len(m.allowedTools) == 0 {
+		return tools
+	}
+	filtered := tools[:0:0]
+	for _, t := range tools {
+		if m.allowedTools[t.Name] {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// parseRoutingHeaders builds a core.Routing from a LiteLLM response,
+// recording every model attempted (fallbacks that 429/5xx'd, followed by
+// the one that finally served the request).
+func parseRoutingHeaders(h http.Header, attempts []string, modelUsed string) core.Routing {
+	r := core.Routing{
+		ModelUsed: modelUsed,
+		Attempts:  attempts,
+		CacheHit:  h.Get(headerCacheStatus) == "hit",
+	}
+	if cost := h.Get(headerResponseCost); cost != "" {
+		r.Cost, _ = strconv.ParseFloat(cost, 64)
+	}
+	return r
+}
+
+// chatCompletionRequest is the OpenAI-compatible /v1/chat/completions
+// request body.
+type chatCompletionRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Tools       []chatTool    `json:"tools,omitempty"`
+	Temperature *float64      `json:"temperature,omitempty"`
+	TopP        *float64      `json:"top_p,omitempty"`
+	Stream      bool          `json:"stream,omitempty"`
+}
+
+type chatMessage struct {
+	Role       string         `json:"role"`
+	Content    string         `json:"content"`
+	ToolCallID string         `json:"tool_call_id,omitempty"`
+	ToolCalls  []chatToolCall `json:"tool_calls,omitempty"`
+}
+
+type chatTool struct {
+	Type     string           `json:"type"`
+	Function chatToolFunction `json:"function"`
+}
+
+type chatToolFunction struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Parameters  map[string]any `json:"parameters,omitempty"`
+}
+
+type chatToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message struct {
+			Content   string         `json:"content"`
+			ToolCalls []chatToolCall `json:"tool_calls"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+func toChatMessages(systemPrompt string, msgs []core.Message) []chatMessage {
+	out := make([]chatMessage, 0, len(msgs)+1)
+	if systemPrompt != "" {
+		out = append(out, chatMessage{Role: string(core.RoleSystem), Content: systemPrompt})
+	}
+	for _, m := range msgs {
+		cm := chatMessage{Role: string(m.Role), Content: m.Content, ToolCallID: m.ToolCallID}
+		for _, tc := range m.ToolCalls {
+			c := chatToolCall{ID: tc.ID, Type: "function"}
+			c.Function.Name = tc.Name
+			c.Function.Arguments = string(tc.Arguments)
+			cm.ToolCalls = append(cm.ToolCalls, c)
+		}
+		out = append(out, cm)
+	}
+	return out
+}
+
+func toChatTools(tools []core.Tool) []chatTool {
+	if len(tools) == 0 {
+		return nil
+	}
+	out := make([]chatTool, 0, len(tools))
+	for _, t := range tools {
+		out = append(out, chatTool{
+			Type: "function",
+			Function: chatToolFunction{
+				Name:        t.Name,
+				Description: t.Description,
+				Parameters:  t.Schema,
+			},
+		})
+	}
+	return out
+}
+
+func toCoreToolCalls(calls []chatToolCall) []core.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]core.ToolCall, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, core.ToolCall{ID: c.ID, Name: c.Function.Name, Arguments: json.RawMessage(c.Function.Arguments)})
+	}
+	return out
+}
+
+func (m *LiteLLM) newRequest(ctx context.Context, body chatCompletionRequest) (*http.Request, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/v1/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	m.setCommonHeaders(req.Header)
+	return req, nil
+}
+
+// Chat implements core.Model. It tries each model in the agent's fallback
+// chain (or this provider's configured model, if none was set) in turn,
+// moving to the next one whenever LiteLLM reports the current model is
+// unavailable (429/5xx).
+func (m *LiteLLM) Chat(ctx context.Context, req core.ChatRequest) (core.ChatResponse, error) {
+	messages := toChatMessages(req.SystemPrompt, req.Messages)
+	if req.SystemPrompt == "" && m.systemPrompt != "" {
+		messages = toChatMessages(m.systemPrompt, req.Messages)
+	}
+	tools := toChatTools(m.filterTools(req.Tools))
+
+	var attempts []string
+	for _, model := range m.modelChain(req) {
+		attempts = append(attempts, model)
+
+		httpReq, err := m.newRequest(ctx, chatCompletionRequest{
+			Model:       model,
+			Messages:    messages,
+			Tools:       tools,
+			Temperature: m.temperature,
+			TopP:        m.topP,
+		})
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+
+		resp, err := m.client.Do(httpReq)
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+		body, err := readAndClose(resp.Body)
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+		if retryableStatus(resp.StatusCode) {
+			continue // fall back to the next model in the chain
+		}
+		if resp.StatusCode >= 400 {
+			return core.ChatResponse{}, fmt.Errorf("openai: litellm returned %d: %s", resp.StatusCode, body)
+		}
+
+		var parsed chatCompletionResponse
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			return core.ChatResponse{}, fmt.Errorf("openai: decoding response: %w", err)
+		}
+		if len(parsed.Choices) == 0 {
+			return core.ChatResponse{}, fmt.Errorf("openai: litellm returned no choices")
+		}
+
+		return core.ChatResponse{
+			Content:   parsed.Choices[0].Message.Content,
+			ToolCalls: toCoreToolCalls(parsed.Choices[0].Message.ToolCalls),
+			Usage: core.Usage{
+				InputTokens:  parsed.Usage.PromptTokens,
+				OutputTokens: parsed.Usage.CompletionTokens,
+			},
+			Routing: parseRoutingHeaders(resp.Header, attempts, model),
+			Budget:  parseBudgetHeaders(resp.Header),
+		}, nil
+	}
+
+	return core.ChatResponse{}, fmt.Errorf("openai: all models in fallback chain exhausted: %v", attempts)
+}
+
+func readAndClose(r io.ReadCloser) ([]byte, error) {
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+type chatCompletionChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content   string              `json:"content"`
+			ToolCalls []chatToolCallDelta `json:"tool_calls"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// chatToolCallDelta is one streamed tool-call fragment; Index says which
+// of the response's tool calls the fragment belongs to.
+type chatToolCallDelta struct {
+	Index int `json:"index"`
+	chatToolCall
+}
+
+// ChatStream implements core.Model's streaming half: it POSTs with
+// "stream": true and parses the server-sent `data: {...}` chunks LiteLLM
+// passes through from the underlying provider, invoking onDelta as text
+// arrives. The same fallback-chain logic as Chat applies before the
+// stream starts; once a model starts streaming it is not retried
+// mid-stream.
+func (m *LiteLLM) ChatStream(ctx context.Context, req core.ChatRequest, onDelta func(string)) (core.ChatResponse, error) {
+	messages := toChatMessages(req.SystemPrompt, req.Messages)
+	if req.SystemPrompt == "" && m.systemPrompt != "" {
+		messages = toChatMessages(m.systemPrompt, req.Messages)
+	}
+	tools := toChatTools(m.filterTools(req.Tools))
+
+	var attempts []string
+	for _, model := range m.modelChain(req) {
+		attempts = append(attempts, model)
+
+		httpReq, err := m.newRequest(ctx, chatCompletionRequest{
+			Model:       model,
+			Messages:    messages,
+			Tools:       tools,
+			Temperature: m.temperature,
+			TopP:        m.topP,
+			Stream:      true,
+		})
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+
+		resp, err := m.client.Do(httpReq)
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+		if retryableStatus(resp.StatusCode) {
+			resp.Body.Close()
+			continue
+		}
+		if resp.StatusCode >= 400 {
+			body, _ := readAndClose(resp.Body)
+			return core.ChatResponse{}, fmt.Errorf("openai: litellm returned %d: %s", resp.StatusCode, body)
+		}
+
+		content, toolCalls, err := readSSE(resp.Body, onDelta)
+		resp.Body.Close()
+		if err != nil {
+			return core.ChatResponse{}, err
+		}
+
+		return core.ChatResponse{
+			Content:   content,
+			ToolCalls: toolCalls,
+			Routing:   parseRoutingHeaders(resp.Header, attempts, model),
+			Budget:    parseBudgetHeaders(resp.Header),
+		}, nil
+	}
+
+	return core.ChatResponse{}, fmt.Errorf("openai: all models in fallback chain exhausted: %v", attempts)
+}
+
+// readSSE reads an OpenAI/LiteLLM "text/event-stream" body, calling
+// onDelta for each content chunk and returning the fully-assembled
+// content and any tool calls once the stream ends.
+func readSSE(body io.Reader, onDelta func(string)) (string, []core.ToolCall, error) {
+	var content strings.Builder
+	pending := map[int]*chatToolCall{}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok {
+			continue
+		}
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk chatCompletionChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			continue // ignore keep-alive/comment lines
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta
+		if delta.Content != "" {
+			content.WriteString(delta.Content)
+			onDelta(delta.Content)
+		}
+		for _, tc := range delta.ToolCalls {
+			i := tc.Index
+			if pending[i] == nil {
+				pending[i] = &chatToolCall{ID: tc.ID, Type: "function"}
+				pending[i].Function.Name = tc.Function.Name
+			}
+			pending[i].Function.Arguments += tc.Function.Arguments
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, fmt.Errorf("openai: reading stream: %w", err)
+	}
+
+	var toolCalls []core.ToolCall
+	for i := 0; i < len(pending); i++ {
+		if tc := pending[i]; tc != nil {
+			toolCalls = append(toolCalls, core.ToolCall{ID: tc.ID, Name: tc.Function.Name, Arguments: json.RawMessage(tc.Function.Arguments)})
+		}
+	}
+	return content.String(), toolCalls, nil
+}
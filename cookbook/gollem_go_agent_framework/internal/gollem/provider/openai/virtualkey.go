@@ -0,0 +1,70 @@
+package openai
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fugue-labs/gollem/core"
+)
+
+// Headers a LiteLLM proxy sends back reporting a virtual key's remaining
+// budget, rate limit, and usage. Populated only when the request
+// authenticated with WithVirtualKey; otherwise left at their zero value.
+const (
+	headerKeySpend         = "x-litellm-key-spend"
+	headerKeyMaxBudget     = "x-litellm-key-budget"
+	headerKeyRemainingReqs = "x-litellm-key-remaining-requests"
+	headerKeyRemainingToks = "x-litellm-key-remaining-tokens"
+)
+
+// WithVirtualKey authenticates as a LiteLLM virtual key (sent as
+// `Authorization: Bearer <key>`) instead of a raw provider API key. LiteLLM
+// tracks per-key budgets, rate limits, and usage against this key.
+func WithVirtualKey(key string) Option {
+	return func(m *LiteLLM) { m.virtualKey = key }
+}
+
+// WithTags attaches LiteLLM request tags (sent via `x-litellm-tags`) used
+// for cost tracking and routing rules.
+func WithTags(tags ...string) Option {
+	return func(m *LiteLLM) { m.tags = append(m.tags, tags...) }
+}
+
+// WithUser attaches an end-user identifier (sent via `x-litellm-user`)
+// used for per-user budgets and usage attribution.
+func WithUser(user string) Option {
+	return func(m *LiteLLM) { m.user = user }
+}
+
+// WithTeam attaches a team identifier (sent via `x-litellm-team`) used
+// for per-team budgets and usage attribution.
+func WithTeam(team string) Option {
+	return func(m *LiteLLM) { m.team = team }
+}
+
+func (m *LiteLLM) setCommonHeaders(h http.Header) {
+	if m.virtualKey != "" {
+		h.Set("Authorization", "Bearer "+m.virtualKey)
+	}
+	if len(m.tags) > 0 {
+		h.Set("x-litellm-tags", strings.Join(m.tags, ","))
+	}
+	if m.user != "" {
+		h.Set("x-litellm-user", m.user)
+	}
+	if m.team != "" {
+		h.Set("x-litellm-team", m.team)
+	}
+}
+
+// parseBudgetHeaders builds a core.Budget from a LiteLLM response's
+// virtual-key budget headers.
+func parseBudgetHeaders(h http.Header) core.Budget {
+	var b core.Budget
+	b.SpentUSD, _ = strconv.ParseFloat(h.Get(headerKeySpend), 64)
+	b.MaxBudgetUSD, _ = strconv.ParseFloat(h.Get(headerKeyMaxBudget), 64)
+	b.RemainingRequests, _ = strconv.Atoi(h.Get(headerKeyRemainingReqs))
+	b.RemainingTokens, _ = strconv.Atoi(h.Get(headerKeyRemainingToks))
+	return b
+}
@@ -0,0 +1,66 @@
+// Model fallback/routing through a LiteLLM proxy.
+//
+// LiteLLM's proxy groups models behind a single deployment and applies
+// fallback/retry/load-balance policies across them. WithModelFallback
+// tells gollem which model to try first and which to fall back to on
+// 429/5xx; Result.Routing surfaces the `x-litellm-*` response headers so
+// the agent can see which model actually served the request.
+//
+// To actually exercise the failover path (rather than just the happy
+// path where the primary always answers), this example deliberately
+// points the primary at a model alias configured in LiteLLM with a
+// rate limit of 1 request/minute and fires several requests back to
+// back. The first request succeeds on the primary; the rest trip
+// LiteLLM's 429 and are retried against the fallbacks automatically.
+//
+// Usage:
+//
+//	litellm --config router_config.yaml   # model group with fallbacks + rate limits
+//	go run ./router
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/fugue-labs/gollem/core"
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+func main() {
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	// WithModelFallback's first argument is the primary model and wins
+	// over any WithModel call on the provider itself, so the provider is
+	// constructed without WithModel here — there's only one place that
+	// decides which model is tried first.
+	model := openai.NewLiteLLM(proxyURL)
+
+	// Try gpt-4o-ratelimited first; fall back to gpt-4o-mini and then
+	// claude-3-haiku if LiteLLM reports a 429/5xx for the primary model.
+	agent := core.NewAgent[string](model,
+		core.WithModelFallback[string]("gpt-4o-ratelimited", "gpt-4o-mini", "claude-3-haiku"),
+	)
+
+	for i := 1; i <= 3; i++ {
+		result, err := agent.Run(context.Background(), "Summarize the CAP theorem in one sentence.")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("request %d: %s\n", i, result.Output)
+		fmt.Printf("  model used: %s\n", result.Routing.ModelUsed)
+		fmt.Printf("  attempts:   %v\n", result.Routing.Attempts)
+		fmt.Printf("  cost:       $%.6f\n", result.Routing.Cost)
+		fmt.Printf("  cache hit:  %t\n\n", result.Routing.CacheHit)
+
+		if len(result.Routing.Attempts) > 1 {
+			fmt.Printf("  -> primary was rate-limited, LiteLLM failed over to %s\n\n", result.Routing.ModelUsed)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Text-to-speech through a LiteLLM proxy.
+//
+// LiteLLM proxies OpenAI's /v1/audio/speech endpoint. When the underlying
+// model supports it, the proxy streams audio bytes as they're generated,
+// analogous to the token streaming in the streaming example.
+//
+// Usage:
+//
+//	litellm --model tts-1
+//	go run ./speech > out.mp3
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+func main() {
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	model := openai.NewLiteLLM(proxyURL,
+		openai.WithModel("tts-1"), // any TTS model configured in LiteLLM
+	)
+
+	audio, err := model.Speech(context.Background(), openai.SpeechRequest{
+		Input:          "Distributed systems must tolerate partial failures.",
+		Voice:          "alloy",
+		ResponseFormat: "mp3",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer audio.Close()
+
+	// Pipe the stream straight to stdout so it can be redirected to a
+	// file or, on systems with `play`, to a speaker in real time:
+	//   go run ./speech | play -t mp3 -
+	if _, err := io.Copy(os.Stdout, audio); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,50 @@
+// Audio transcription through a LiteLLM proxy.
+//
+// LiteLLM proxies OpenAI's /v1/audio/transcriptions endpoint (Whisper),
+// so any transcription model configured in LiteLLM can be used from
+// gollem agents.
+//
+// Usage:
+//
+//	litellm --model whisper-1
+//	go run ./transcribe path/to/audio.mp3
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("usage: transcribe <audio file>")
+	}
+
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	model := openai.NewLiteLLM(proxyURL,
+		openai.WithModel("whisper-1"), // any transcription model configured in LiteLLM
+	)
+
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	text, err := model.Transcribe(context.Background(), f, openai.TranscribeOpts{
+		Language:       "en",
+		ResponseFormat: "text",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(text)
+}
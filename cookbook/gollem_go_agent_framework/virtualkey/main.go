@@ -0,0 +1,68 @@
+// Virtual-key authentication and budget surfacing through a LiteLLM proxy.
+//
+// LiteLLM's proxy supports virtual API keys with per-key budgets, rate
+// limits, tags, and team IDs. WithVirtualKey/WithTags/WithUser/WithTeam
+// attach that context to every request; Result.Budget reports the
+// remaining budget and rate limit headers LiteLLM sends back.
+//
+// Usage:
+//
+//	litellm --model gpt-4o
+//	export LITELLM_VIRTUAL_KEY=sk-...
+//	go run ./virtualkey
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/fugue-labs/gollem/core"
+	"github.com/fugue-labs/gollem/provider/openai"
+)
+
+// budgetWarningThresholdUSD is how close to the key's max budget we allow
+// before warning the caller instead of silently continuing to spend.
+const budgetWarningThresholdUSD = 1.00
+
+func main() {
+	proxyURL := "http://localhost:4000"
+	if u := os.Getenv("LITELLM_PROXY_URL"); u != "" {
+		proxyURL = u
+	}
+
+	model := openai.NewLiteLLM(proxyURL,
+		openai.WithModel("gpt-4o"),
+		openai.WithVirtualKey(os.Getenv("LITELLM_VIRTUAL_KEY")),
+		openai.WithTags("cookbook", "virtualkey-example"),
+		openai.WithUser("alice@example.com"),
+		openai.WithTeam("platform"),
+	)
+
+	agent := core.NewAgent[string](model)
+
+	result, err := agent.Run(context.Background(), "Give me a one-sentence fun fact about octopuses.")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(result.Output)
+
+	budget := result.Budget
+	remaining := budget.MaxBudgetUSD - budget.SpentUSD
+	fmt.Printf("\nspent: $%.4f / $%.4f, remaining requests: %d, remaining tokens: %d\n",
+		budget.SpentUSD, budget.MaxBudgetUSD, budget.RemainingRequests, budget.RemainingTokens)
+
+	if budget.MaxBudgetUSD > 0 && remaining < budgetWarningThresholdUSD {
+		fmt.Printf("warning: only $%.4f of budget left on this virtual key — degrading to a cheaper model\n", remaining)
+
+		// Same provider, so the same virtual key, tags, user and team;
+		// WithModelFallback's primary takes precedence over WithModel.
+		cheaper := core.NewAgent[string](model, core.WithModelFallback[string]("gpt-4o-mini"))
+		result, err = cheaper.Run(context.Background(), "Give me another one-sentence fun fact about octopuses.")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(result.Output)
+	}
+}